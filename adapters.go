@@ -0,0 +1,108 @@
+package zaplogger
+
+import (
+	"log"
+	"strings"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc/grpclog"
+)
+
+// baseAdapterLogger builds a plain ZapLogger for the given level name, with
+// no extra caller skip of its own. Each adapter below adds whatever skip
+// its own call shape needs on top of this.
+func baseAdapterLogger(level string) *ZapLogger {
+	lvl, err := ParseLevel(level)
+	if err != nil {
+		lvl = zapcore.InfoLevel
+	}
+	return NewZapLogger(&LogConfig{}, lvl)
+}
+
+// gRPCLogger adapts a ZapLogger to grpclog.LoggerV2, mirroring the frostfs
+// gRPCLogger pattern so this module can be plugged in via
+// grpclog.SetLoggerV2.
+type gRPCLogger struct {
+	*ZapLogger
+	verbosity int
+}
+
+// NewGRPCLogger builds a grpclog.LoggerV2 backed by a ZapLogger at level.
+// gRPCLogger's methods call sugarLogger directly, the same single wrapper
+// frame as ZapLogger's own Info/Warn/etc, so the base logger's caller skip
+// already points at the real call site without any adjustment.
+func NewGRPCLogger(level string) grpclog.LoggerV2 {
+	zl := baseAdapterLogger(level)
+	verbosity := 0
+	switch {
+	case zl.GetLevel() <= zapcore.DebugLevel:
+		verbosity = 2
+	case zl.GetLevel() <= zapcore.InfoLevel:
+		verbosity = 1
+	}
+	return &gRPCLogger{ZapLogger: zl, verbosity: verbosity}
+}
+
+func (g *gRPCLogger) Info(args ...interface{})                    { g.sugarLogger.Info(args...) }
+func (g *gRPCLogger) Infoln(args ...interface{})                  { g.sugarLogger.Info(args...) }
+func (g *gRPCLogger) Infof(format string, args ...interface{})    { g.sugarLogger.Infof(format, args...) }
+func (g *gRPCLogger) Warning(args ...interface{})                 { g.sugarLogger.Warn(args...) }
+func (g *gRPCLogger) Warningln(args ...interface{})               { g.sugarLogger.Warn(args...) }
+func (g *gRPCLogger) Warningf(format string, args ...interface{}) { g.sugarLogger.Warnf(format, args...) }
+func (g *gRPCLogger) Error(args ...interface{})                   { g.sugarLogger.Error(args...) }
+func (g *gRPCLogger) Errorln(args ...interface{})                 { g.sugarLogger.Error(args...) }
+func (g *gRPCLogger) Errorf(format string, args ...interface{})   { g.sugarLogger.Errorf(format, args...) }
+func (g *gRPCLogger) Fatal(args ...interface{})                   { g.sugarLogger.Fatal(args...) }
+func (g *gRPCLogger) Fatalln(args ...interface{})                 { g.sugarLogger.Fatal(args...) }
+func (g *gRPCLogger) Fatalf(format string, args ...interface{})   { g.sugarLogger.Fatalf(format, args...) }
+
+// V reports whether verbosity level l is enabled, per grpclog.LoggerV2.
+func (g *gRPCLogger) V(l int) bool {
+	return l <= g.verbosity
+}
+
+// stdLogWriter routes standard library log.Logger output to a ZapLogger at a
+// fixed level, like ZapLogWriter, but through a logger whose caller skip
+// accounts for log.Logger's own indirection.
+type stdLogWriter struct {
+	zl    *ZapLogger
+	level zapcore.Level
+}
+
+func (w *stdLogWriter) Write(b []byte) (int, error) {
+	msg := strings.TrimRight(string(b), "\n")
+	switch w.level {
+	case zapcore.DebugLevel:
+		w.zl.sugarLogger.Debug(msg)
+	case zapcore.WarnLevel:
+		w.zl.sugarLogger.Warn(msg)
+	case zapcore.ErrorLevel:
+		w.zl.sugarLogger.Error(msg)
+	case zapcore.DPanicLevel:
+		w.zl.sugarLogger.DPanic(msg)
+	case zapcore.PanicLevel:
+		w.zl.sugarLogger.Panic(msg)
+	case zapcore.FatalLevel:
+		w.zl.sugarLogger.Fatal(msg)
+	default:
+		w.zl.sugarLogger.Info(msg)
+	}
+	return len(b), nil
+}
+
+// NewStdLogger builds a standard library *log.Logger that writes every
+// entry through a ZapLogger at level, preserving caller info. Unlike
+// ZapLogWriter, it's safe to plug into http.Server.ErrorLog or any other
+// library that expects a *log.Logger.
+//
+// A call site reaches stdLogWriter.Write through two extra frames the
+// gRPC adapter doesn't have: log.Logger's own Print/Printf/... method, then
+// Output, before Write calls sugarLogger directly. AddCallerSkip(2) on top
+// of the base logger's skip corrects for those.
+func NewStdLogger(level string) *log.Logger {
+	zl := baseAdapterLogger(level)
+	zl.zapLogger = zl.zapLogger.WithOptions(zap.AddCallerSkip(2))
+	zl.sugarLogger = zl.zapLogger.Sugar()
+	return log.New(&stdLogWriter{zl: zl, level: zl.GetLevel()}, "", 0)
+}
@@ -0,0 +1,66 @@
+package zaplogger
+
+import (
+	"fmt"
+	"net/http"
+
+	"go.uber.org/zap/zapcore"
+)
+
+// SetLevel changes the minimum enabled level for this logger at runtime.
+func (z *ZapLogger) SetLevel(level zapcore.Level) {
+	z.atomicLevel.SetLevel(level)
+}
+
+// GetLevel returns the logger's current minimum enabled level.
+func (z *ZapLogger) GetLevel() zapcore.Level {
+	return z.atomicLevel.Level()
+}
+
+// ServeHTTP lets operators GET or PUT the current log level at runtime,
+// e.g. `curl -X PUT -d '{"level":"debug"}' localhost:PORT/log/level`.
+// It delegates to zap's own AtomicLevel.ServeHTTP.
+func (z *ZapLogger) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	z.atomicLevel.ServeHTTP(w, r)
+}
+
+// SetLevel changes the minimum enabled level of the default logger at runtime.
+func SetLevel(level zapcore.Level) {
+	resetLock.RLock()
+	defaultZapLogger.SetLevel(level)
+	resetLock.RUnlock()
+}
+
+// GetLevel returns the default logger's current minimum enabled level.
+func GetLevel() zapcore.Level {
+	resetLock.RLock()
+	defer resetLock.RUnlock()
+	return defaultZapLogger.GetLevel()
+}
+
+// SetDefaultLevel changes the minimum enabled level of the default logger at runtime.
+func SetDefaultLevel(level zapcore.Level) {
+	SetLevel(level)
+}
+
+// LevelHandler exposes the default logger's level endpoint, so it can be
+// registered directly with an http.ServeMux, e.g. mux.Handle("/log/level", zaplogger.LevelHandler()).
+func LevelHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resetLock.RLock()
+		zl := defaultZapLogger
+		resetLock.RUnlock()
+		zl.ServeHTTP(w, r)
+	})
+}
+
+// ParseLevel parses a level name ("debug", "info", "warn", "error", "dpanic",
+// "panic", "fatal", case-insensitive) into a zapcore.Level, so config files
+// can specify the level by name instead of an integer.
+func ParseLevel(levelStr string) (zapcore.Level, error) {
+	var level zapcore.Level
+	if err := level.UnmarshalText([]byte(levelStr)); err != nil {
+		return level, fmt.Errorf("invalid log level %q: %w", levelStr, err)
+	}
+	return level, nil
+}
@@ -1,6 +1,7 @@
 package zaplogger
 
 import (
+	"context"
 	"errors"
 	"github.com/natefinch/lumberjack"
 	"go.uber.org/zap"
@@ -8,6 +9,7 @@ import (
 	"io"
 	"os"
 	"sync"
+	"time"
 )
 
 var (
@@ -19,7 +21,9 @@ type ZapLogger struct {
 	sugarLogger *zap.SugaredLogger
 	zapLogger *zap.Logger
 	logWriter io.Writer
+	logWriters []io.Writer
 	config *LogConfig
+	atomicLevel zap.AtomicLevel
 }
 
 func (z *ZapLogger) GetSugarLogger() *zap.SugaredLogger {
@@ -36,44 +40,119 @@ type LogConfig struct {
 	FileMaxBackup int
 	FileMaxAge int
 	FileCompress bool
-}
-
-func NewZapLogger(config *LogConfig, logLevel zapcore.LevelEnabler) (zl *ZapLogger) {
-	if config.FileMaxAge <= 0 {
-		config.FileMaxAge = 30
-	}
-
-	if config.FileMaxBackup <= 0 {
-		config.FileMaxBackup = 3
-	}
-
-	if config.FileMaxSize <= 0 {
-		config.FileMaxSize = 100
-	}
+	// ContextKeys are the context.Value keys WithContext reads by default.
+	ContextKeys []string
+	// ContextExtractor overrides how WithContext pulls fields out of a
+	// context.Context, e.g. to integrate with OpenTelemetry spans or a
+	// framework's own request-scoped values instead of ContextKeys.
+	ContextExtractor func(ctx context.Context) []zap.Field
+	// Format selects the encoder: "console" (default) or "json". Services
+	// shipping to ELK/Loki want "json"; local development wants "console".
+	Format string
+	// EnableColor switches the console encoder to colorize level names.
+	// Ignored when Format is "json".
+	EnableColor bool
+	// TimeFormat is a time.Layout string for the timestamp field. Defaults
+	// to ISO8601 when empty.
+	TimeFormat string
+	// MessageKey, LevelKey, TimeKey, CallerKey and StacktraceKey override the
+	// corresponding field names in the encoded output. Each defaults to
+	// zap's production encoder config when left empty.
+	MessageKey string
+	LevelKey string
+	TimeKey string
+	CallerKey string
+	StacktraceKey string
+	// Sinks, when non-empty, replaces the single FilenameOrIoWriter
+	// destination above with a list of independently-rotated destinations
+	// combined with zapcore.NewTee, e.g. errors to error.log + stderr while
+	// everything else goes to all.log + stdout.
+	Sinks []Sink
+	// Sampling enables zap's log sampling, so high-volume services can cap
+	// throughput instead of writing every repeated entry. Initial and
+	// Thereafter default to 100 when left at zero.
+	Sampling *zap.SamplingConfig
+	// StacktraceLevel, when set, attaches a stacktrace to every entry at or
+	// above this level (typically zapcore.ErrorLevel).
+	StacktraceLevel *zapcore.Level
+}
+
+func NewZapLogger(config *LogConfig, level zapcore.Level) (zl *ZapLogger) {
 	zl = new(ZapLogger)
 	zl.config = config
-	var err error
-	zl.logWriter, err = getWriter(config)
+	zl.atomicLevel = zap.NewAtomicLevelAt(level)
+	encoder := getEncoder(config)
+
+	sinks := config.Sinks
+	if len(sinks) == 0 {
+		sinks = []Sink{{
+			FilenameOrIoWriter: config.FilenameOrIoWriter,
+			FileMaxSize:        config.FileMaxSize,
+			FileMaxBackup:      config.FileMaxBackup,
+			FileMaxAge:         config.FileMaxAge,
+			FileCompress:       config.FileCompress,
+		}}
+	}
 
-	if err != nil {
-		config.FilenameOrIoWriter = os.Stdout
-		zl.logWriter, err = getWriter(config)
+	cores := make([]zapcore.Core, 0, len(sinks))
+	for i, sink := range sinks {
+		sink.applyDefaults()
+
+		writer, err := getWriter(sink.FilenameOrIoWriter, sink.FileMaxSize, sink.FileMaxBackup, sink.FileMaxAge, sink.FileCompress)
 		if err != nil {
-			panic("Fail to enable default logWriter: " + err.Error())
+			sink.FilenameOrIoWriter = os.Stdout
+			writer, err = getWriter(sink.FilenameOrIoWriter, sink.FileMaxSize, sink.FileMaxBackup, sink.FileMaxAge, sink.FileCompress)
+			if err != nil {
+				panic("Fail to enable default logWriter: " + err.Error())
+			}
+		}
+		if i == 0 {
+			zl.logWriter = writer
+		}
+		zl.logWriters = append(zl.logWriters, writer)
+
+		ws := zapcore.AddSync(writer)
+		if sink.Mirror != nil {
+			ws = zapcore.NewMultiWriteSyncer(ws, zapcore.AddSync(sink.Mirror))
 		}
+
+		enabler := sink.LevelEnabler
+		if enabler == nil {
+			enabler = zl.atomicLevel
+		}
+		cores = append(cores, zapcore.NewCore(encoder, ws, enabler))
 	}
+	core := zapcore.NewTee(cores...)
 
-	encoder := getEncoder()
-	core := zapcore.NewCore(encoder, zapcore.AddSync(zl.logWriter), logLevel)
+	opts := []zap.Option{zap.AddCaller(), zap.AddCallerSkip(1)}
+	if config.StacktraceLevel != nil {
+		opts = append(opts, zap.AddStacktrace(*config.StacktraceLevel))
+	}
+	if config.Sampling != nil {
+		initial, thereafter := config.Sampling.Initial, config.Sampling.Thereafter
+		if initial <= 0 {
+			initial = 100
+		}
+		if thereafter <= 0 {
+			thereafter = 100
+		}
+		var samplerOpts []zapcore.SamplerOption
+		if config.Sampling.Hook != nil {
+			samplerOpts = append(samplerOpts, zapcore.SamplerHook(config.Sampling.Hook))
+		}
+		opts = append(opts, zap.WrapCore(func(c zapcore.Core) zapcore.Core {
+			return zapcore.NewSamplerWithOptions(c, time.Second, initial, thereafter, samplerOpts...)
+		}))
+	}
 
-	zl.zapLogger = zap.New(core, zap.AddCaller(),zap.AddCallerSkip(1))
+	zl.zapLogger = zap.New(core, opts...)
 	zl.sugarLogger = zl.zapLogger.Sugar()
 	return zl
 }
 
-func InitDefaultLogger(config *LogConfig, logLevel zapcore.LevelEnabler) {
+func InitDefaultLogger(config *LogConfig, level zapcore.Level) {
 	resetLock.Lock()
-	defaultZapLogger = NewZapLogger(config, logLevel)
+	defaultZapLogger = NewZapLogger(config, level)
 	resetLock.Unlock()
 }
 
@@ -81,26 +160,56 @@ func init() {
 	InitDefaultLogger(&LogConfig{}, zap.DebugLevel)
 }
 
-func getWriter(config *LogConfig) (io.Writer, error) {
-	switch config.FilenameOrIoWriter.(type) {
+func getWriter(filenameOrIoWriter interface{}, maxSize, maxBackup, maxAge int, compress bool) (io.Writer, error) {
+	switch w := filenameOrIoWriter.(type) {
 	case string:
 		return &lumberjack.Logger{
-			Filename:   config.FilenameOrIoWriter.(string),
-			MaxSize:    config.FileMaxSize,
-			MaxBackups: config.FileMaxBackup,
-			MaxAge:     config.FileMaxAge,
-			Compress:   config.FileCompress,
+			Filename:   w,
+			MaxSize:    maxSize,
+			MaxBackups: maxBackup,
+			MaxAge:     maxAge,
+			Compress:   compress,
 		}, nil
 	case io.Writer:
-		return config.FilenameOrIoWriter.(io.Writer), nil
+		return w, nil
 	}
 	return nil, errors.New("string / io.Writer only")
 }
 
-func getEncoder() zapcore.Encoder {
+func getEncoder(config *LogConfig) zapcore.Encoder {
 	encoderConfig := zap.NewProductionEncoderConfig()
-	encoderConfig.EncodeTime = zapcore.ISO8601TimeEncoder
-	encoderConfig.EncodeLevel = zapcore.CapitalLevelEncoder
+
+	if config.TimeFormat != "" {
+		encoderConfig.EncodeTime = zapcore.TimeEncoderOfLayout(config.TimeFormat)
+	} else {
+		encoderConfig.EncodeTime = zapcore.ISO8601TimeEncoder
+	}
+
+	if config.EnableColor && config.Format != "json" {
+		encoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
+	} else {
+		encoderConfig.EncodeLevel = zapcore.CapitalLevelEncoder
+	}
+
+	if config.MessageKey != "" {
+		encoderConfig.MessageKey = config.MessageKey
+	}
+	if config.LevelKey != "" {
+		encoderConfig.LevelKey = config.LevelKey
+	}
+	if config.TimeKey != "" {
+		encoderConfig.TimeKey = config.TimeKey
+	}
+	if config.CallerKey != "" {
+		encoderConfig.CallerKey = config.CallerKey
+	}
+	if config.StacktraceKey != "" {
+		encoderConfig.StacktraceKey = config.StacktraceKey
+	}
+
+	if config.Format == "json" {
+		return zapcore.NewJSONEncoder(encoderConfig)
+	}
 	return zapcore.NewConsoleEncoder(encoderConfig)
 }
 
@@ -0,0 +1,37 @@
+package zaplogger
+
+import (
+	"io"
+
+	"go.uber.org/zap/zapcore"
+)
+
+// Sink is one destination in a multi-sink LogConfig, e.g. errors to
+// error.log + stderr while everything else goes to all.log + stdout. Sinks
+// are combined with zapcore.NewTee, all sharing the same encoder.
+type Sink struct {
+	FilenameOrIoWriter interface{}
+	FileMaxSize        int
+	FileMaxBackup      int
+	FileMaxAge         int
+	FileCompress       bool
+	// LevelEnabler selects which entries reach this sink, e.g.
+	// zap.LevelEnablerFunc(func(l zapcore.Level) bool { return l >= zapcore.ErrorLevel }).
+	// Defaults to the logger's own AtomicLevel when nil.
+	LevelEnabler zapcore.LevelEnabler
+	// Mirror, when set, additionally writes every entry reaching this sink
+	// to this writer, e.g. os.Stdout or os.Stderr.
+	Mirror io.Writer
+}
+
+func (s *Sink) applyDefaults() {
+	if s.FileMaxAge <= 0 {
+		s.FileMaxAge = 30
+	}
+	if s.FileMaxBackup <= 0 {
+		s.FileMaxBackup = 3
+	}
+	if s.FileMaxSize <= 0 {
+		s.FileMaxSize = 100
+	}
+}
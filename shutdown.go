@@ -0,0 +1,74 @@
+package zaplogger
+
+import (
+	"errors"
+	"time"
+
+	"github.com/natefinch/lumberjack"
+)
+
+// DefaultFlushTimeout is used by the package-level Flush when it is given a
+// timeout of zero or less.
+const DefaultFlushTimeout = 3 * time.Second
+
+// Sync flushes any buffered log entries. Applications should call this
+// before exiting.
+func (z *ZapLogger) Sync() error {
+	return z.zapLogger.Sync()
+}
+
+// Close flushes buffered log entries and closes any lumberjack.Logger this
+// ZapLogger owns.
+func (z *ZapLogger) Close() error {
+	err := z.Sync()
+	for _, w := range z.logWriters {
+		if lj, ok := w.(*lumberjack.Logger); ok {
+			if cerr := lj.Close(); err == nil {
+				err = cerr
+			}
+		}
+	}
+	return err
+}
+
+// Flush calls Sync but gives up after timeout, so a hung writer (e.g. an
+// unreachable network sink) can't block shutdown forever.
+func (z *ZapLogger) Flush(timeout time.Duration) error {
+	done := make(chan error, 1)
+	go func() {
+		done <- z.Sync()
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-time.After(timeout):
+		return errors.New("zaplogger: timed out flushing log")
+	}
+}
+
+// Sync flushes any buffered entries on the default logger.
+func Sync() error {
+	resetLock.RLock()
+	defer resetLock.RUnlock()
+	return defaultZapLogger.Sync()
+}
+
+// Close flushes and releases the resources owned by the default logger.
+func Close() error {
+	resetLock.RLock()
+	defer resetLock.RUnlock()
+	return defaultZapLogger.Close()
+}
+
+// Flush flushes the default logger, giving up after timeout, or after
+// DefaultFlushTimeout when timeout is zero or less.
+func Flush(timeout time.Duration) error {
+	if timeout <= 0 {
+		timeout = DefaultFlushTimeout
+	}
+	resetLock.RLock()
+	zl := defaultZapLogger
+	resetLock.RUnlock()
+	return zl.Flush(timeout)
+}
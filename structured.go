@@ -0,0 +1,147 @@
+package zaplogger
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+)
+
+// defaultContextKeys are the context.Value keys WithContext falls back to
+// when LogConfig.ContextKeys is empty.
+var defaultContextKeys = []string{"trace_id", "request_id", "user_id"}
+
+// Logger is the structured subset of ZapLogger's API, so downstream code can
+// depend on an interface instead of the concrete *ZapLogger.
+type Logger interface {
+	Debugw(msg string, keysAndValues ...interface{})
+	Infow(msg string, keysAndValues ...interface{})
+	Warnw(msg string, keysAndValues ...interface{})
+	Errorw(msg string, keysAndValues ...interface{})
+	DPanicw(msg string, keysAndValues ...interface{})
+	Fatalw(msg string, keysAndValues ...interface{})
+}
+
+var _ Logger = (*ZapLogger)(nil)
+
+func (z *ZapLogger) Debugw(msg string, keysAndValues ...interface{}) {
+	z.sugarLogger.Debugw(msg, keysAndValues...)
+}
+
+func (z *ZapLogger) Infow(msg string, keysAndValues ...interface{}) {
+	z.sugarLogger.Infow(msg, keysAndValues...)
+}
+
+func (z *ZapLogger) Warnw(msg string, keysAndValues ...interface{}) {
+	z.sugarLogger.Warnw(msg, keysAndValues...)
+}
+
+func (z *ZapLogger) Errorw(msg string, keysAndValues ...interface{}) {
+	z.sugarLogger.Errorw(msg, keysAndValues...)
+}
+
+func (z *ZapLogger) DPanicw(msg string, keysAndValues ...interface{}) {
+	z.sugarLogger.DPanicw(msg, keysAndValues...)
+}
+
+func (z *ZapLogger) Fatalw(msg string, keysAndValues ...interface{}) {
+	z.sugarLogger.Fatalw(msg, keysAndValues...)
+}
+
+func Debugw(msg string, keysAndValues ...interface{}) {
+	resetLock.RLock()
+	defaultZapLogger.sugarLogger.Debugw(msg, keysAndValues...)
+	resetLock.RUnlock()
+}
+
+func Infow(msg string, keysAndValues ...interface{}) {
+	resetLock.RLock()
+	defaultZapLogger.sugarLogger.Infow(msg, keysAndValues...)
+	resetLock.RUnlock()
+}
+
+func Warnw(msg string, keysAndValues ...interface{}) {
+	resetLock.RLock()
+	defaultZapLogger.sugarLogger.Warnw(msg, keysAndValues...)
+	resetLock.RUnlock()
+}
+
+func Errorw(msg string, keysAndValues ...interface{}) {
+	resetLock.RLock()
+	defaultZapLogger.sugarLogger.Errorw(msg, keysAndValues...)
+	resetLock.RUnlock()
+}
+
+func DPanicw(msg string, keysAndValues ...interface{}) {
+	resetLock.RLock()
+	defaultZapLogger.sugarLogger.DPanicw(msg, keysAndValues...)
+	resetLock.RUnlock()
+}
+
+func Fatalw(msg string, keysAndValues ...interface{}) {
+	resetLock.RLock()
+	defaultZapLogger.sugarLogger.Fatalw(msg, keysAndValues...)
+	resetLock.RUnlock()
+}
+
+// With returns a child logger that shares this logger's writer and config
+// but has the given fields added to every subsequent log entry.
+func (z *ZapLogger) With(fields ...zap.Field) *ZapLogger {
+	child := new(ZapLogger)
+	*child = *z
+	child.zapLogger = z.zapLogger.With(fields...)
+	child.sugarLogger = child.zapLogger.Sugar()
+	return child
+}
+
+// Named returns a child logger with name appended to this logger's name,
+// sharing the underlying writer and config.
+func (z *ZapLogger) Named(name string) *ZapLogger {
+	child := new(ZapLogger)
+	*child = *z
+	child.zapLogger = z.zapLogger.Named(name)
+	child.sugarLogger = child.zapLogger.Sugar()
+	return child
+}
+
+// WithContext returns a child logger with fields extracted from ctx attached.
+// By default it looks up LogConfig.ContextKeys (trace_id, request_id,
+// user_id when unset) via ctx.Value; set LogConfig.ContextExtractor to
+// integrate with OpenTelemetry, gin request IDs, etc. instead.
+func (z *ZapLogger) WithContext(ctx context.Context) *ZapLogger {
+	fields := z.extractContextFields(ctx)
+	if len(fields) == 0 {
+		return z
+	}
+	return z.With(fields...)
+}
+
+// extractContextFields looks keys up via ctx.Value(string), the same plain
+// string-keyed lookup context.WithValue's docs warn can collide with values
+// another package stored under the same literal string. Prefer
+// LogConfig.ContextExtractor when integrating with a framework/tracer that
+// uses its own unexported key type.
+func (z *ZapLogger) extractContextFields(ctx context.Context) []zap.Field {
+	if z.config != nil && z.config.ContextExtractor != nil {
+		return z.config.ContextExtractor(ctx)
+	}
+	keys := defaultContextKeys
+	if z.config != nil && len(z.config.ContextKeys) > 0 {
+		keys = z.config.ContextKeys
+	}
+	fields := make([]zap.Field, 0, len(keys))
+	for _, key := range keys {
+		if v := ctx.Value(key); v != nil {
+			fields = append(fields, zap.Any(key, v))
+		}
+	}
+	return fields
+}
+
+// WithContext returns a child of the default logger with fields extracted
+// from ctx attached; the default logger itself is unchanged. See
+// (*ZapLogger).WithContext for how fields are extracted.
+func WithContext(ctx context.Context) *ZapLogger {
+	resetLock.RLock()
+	defer resetLock.RUnlock()
+	return defaultZapLogger.WithContext(ctx)
+}